@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// Benchmark describes a standard optimization test function over the 2D
+// Arg domain, along with the metadata needed to search it correctly.
+type Benchmark struct {
+	Name       string
+	Dimensions int
+	LowerBound float64
+	UpperBound float64
+	Optimum    Arg
+	Func       func(Arg) float64
+}
+
+var benchmarks = map[string]Benchmark{
+	"booth": {
+		Name: "booth", Dimensions: 2, LowerBound: -10, UpperBound: 10,
+		Optimum: Arg{x: 1, y: 3},
+		Func: func(entry Arg) float64 {
+			a := entry.x + 2*entry.y - 7
+			b := 2*entry.x + entry.y - 5
+			return a*a + b*b
+		},
+	},
+	"beale": {
+		Name: "beale", Dimensions: 2, LowerBound: -4.5, UpperBound: 4.5,
+		Optimum: Arg{x: 3, y: 0.5},
+		Func: func(entry Arg) float64 {
+			return math.Pow(1.5-entry.x+entry.x*entry.y, 2) +
+				math.Pow(2.25-entry.x+entry.x*entry.y*entry.y, 2) +
+				math.Pow(2.625-entry.x+entry.x*entry.y*entry.y*entry.y, 2)
+		},
+	},
+	"camel": {
+		Name: "camel", Dimensions: 2, LowerBound: -5, UpperBound: 5,
+		Optimum: Arg{x: 0, y: 0},
+		Func: func(entry Arg) float64 {
+			return 2*entry.x*entry.x - 1.05*math.Pow(entry.x, 4) + math.Pow(entry.x, 6)/6 + entry.x*entry.y + entry.y*entry.y
+		},
+	},
+	"himmelblau": {
+		Name: "himmelblau", Dimensions: 2, LowerBound: -5, UpperBound: 5,
+		Optimum: Arg{x: 3, y: 2},
+		Func: func(entry Arg) float64 {
+			a := entry.x*entry.x + entry.y - 11
+			b := entry.x + entry.y*entry.y - 7
+			return a*a + b*b
+		},
+	},
+	"rastrigin": {
+		Name: "rastrigin", Dimensions: 2, LowerBound: -5.12, UpperBound: 5.12,
+		Optimum: Arg{x: 0, y: 0},
+		Func: func(entry Arg) float64 {
+			const a = 10
+			return 2*a +
+				(entry.x*entry.x - a*math.Cos(2*math.Pi*entry.x)) +
+				(entry.y*entry.y - a*math.Cos(2*math.Pi*entry.y))
+		},
+	},
+	"ackley": {
+		Name: "ackley", Dimensions: 2, LowerBound: -32.768, UpperBound: 32.768,
+		Optimum: Arg{x: 0, y: 0},
+		Func: func(entry Arg) float64 {
+			sumSq := entry.x*entry.x + entry.y*entry.y
+			sumCos := math.Cos(2*math.Pi*entry.x) + math.Cos(2*math.Pi*entry.y)
+			return -20*math.Exp(-0.2*math.Sqrt(sumSq/2)) - math.Exp(sumCos/2) + 20 + math.E
+		},
+	},
+	"schwefel": {
+		Name: "schwefel", Dimensions: 2, LowerBound: -500, UpperBound: 500,
+		Optimum: Arg{x: 420.9687, y: 420.9687},
+		Func: func(entry Arg) float64 {
+			const d = 2
+			return 418.9829*d -
+				entry.x*math.Sin(math.Sqrt(math.Abs(entry.x))) -
+				entry.y*math.Sin(math.Sqrt(math.Abs(entry.y)))
+		},
+	},
+	"rosenbrock": {
+		Name: "rosenbrock", Dimensions: 2, LowerBound: -5, UpperBound: 10,
+		Optimum: Arg{x: 1, y: 1},
+		Func: func(entry Arg) float64 {
+			return 100*math.Pow(entry.y-entry.x*entry.x, 2) + math.Pow(1-entry.x, 2)
+		},
+	},
+}
+
+// activeBenchmark is the benchmark currently in use by calculate, makeNewEntry,
+// and the DE/PSO bounds clamping. It defaults to Beale to match historical
+// behavior and is switched via SetBenchmark.
+var activeBenchmark = benchmarks["beale"]
+
+// SetBenchmark makes name (see benchmarks) the active benchmark for
+// calculate, population generation, and bounds clamping.
+func SetBenchmark(name string) error {
+	b, ok := benchmarks[name]
+	if !ok {
+		return fmt.Errorf("unknown benchmark %q", name)
+	}
+	activeBenchmark = b
+	return nil
+}
+
+// BenchmarkNames lists the benchmarks available to SetBenchmark, in a
+// stable order suitable for sweeping over.
+func BenchmarkNames() []string {
+	return []string{"booth", "beale", "camel", "himmelblau", "rastrigin", "ackley", "schwefel", "rosenbrock"}
+}
@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestSetBenchmarkRejectsUnknownName(t *testing.T) {
+	if err := SetBenchmark("not-a-real-benchmark"); err == nil {
+		t.Error("SetBenchmark with unknown name: expected error, got nil")
+	}
+}
+
+func TestSetBenchmarkSwitchesActiveBenchmark(t *testing.T) {
+	defer SetBenchmark("beale")
+
+	for _, name := range BenchmarkNames() {
+		if err := SetBenchmark(name); err != nil {
+			t.Fatalf("SetBenchmark(%q): unexpected error: %v", name, err)
+		}
+		if activeBenchmark.Name != name {
+			t.Errorf("after SetBenchmark(%q), activeBenchmark.Name = %q", name, activeBenchmark.Name)
+		}
+	}
+}
+
+func TestBenchmarkNamesMatchRegistry(t *testing.T) {
+	for _, name := range BenchmarkNames() {
+		if _, ok := benchmarks[name]; !ok {
+			t.Errorf("BenchmarkNames lists %q, which is not in the benchmarks registry", name)
+		}
+	}
+}
+
+func TestBenchmarkFuncAttainsOptimum(t *testing.T) {
+	// schwefel's optimum (420.9687...) is an irrational constant truncated
+	// in the registry, so its Func value is only approximately zero.
+	const tolerance = 1e-4
+	for _, name := range BenchmarkNames() {
+		entry := benchmarks[name]
+		if got := entry.Func(entry.Optimum); got > tolerance {
+			t.Errorf("%s: Func(Optimum) = %v, want ~0", name, got)
+		}
+	}
+}
+
+func TestRunSetsBenchmarkFromSettings(t *testing.T) {
+	defer SetBenchmark("beale")
+	SetBenchmark("beale")
+
+	settings := GeneticAlgorithmSettings{PopulationSize: 10, MutationRate: 2, CrossoverRate: 100, NumGenerations: 1, Seed: 1, BenchName: "booth"}
+	if _, _, err := Run(GA{}, settings); err != nil {
+		t.Fatalf("Run: unexpected error: %v", err)
+	}
+	if activeBenchmark.Name != "booth" {
+		t.Errorf("after Run with BenchName %q, activeBenchmark.Name = %q", settings.BenchName, activeBenchmark.Name)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// DEStrategy selects which vector a DE mutant is built around.
+type DEStrategy int
+
+const (
+	// DERandOneBin builds the mutant around a random individual: DE/rand/1/bin.
+	DERandOneBin DEStrategy = iota
+	// DEBestOneBin builds the mutant around the best individual found so far: DE/best/1/bin.
+	DEBestOneBin
+)
+
+type DESettings struct {
+	PopulationSize int
+	NumGenerations int
+	F              float64 // differential weight
+	CR             float64 // crossover rate, in [0,1]
+	Strategy       DEStrategy
+	Seed           int64
+}
+
+// validateDESettings checks that settings describe a runnable DE: the
+// mutation step needs the target plus three other distinct individuals, so
+// PopulationSize must be at least 4, and NumGenerations must be non-negative.
+func validateDESettings(settings DESettings) error {
+	if settings.PopulationSize < 4 {
+		return fmt.Errorf("PopulationSize must be at least 4 (target + 3 distinct individuals), got %d", settings.PopulationSize)
+	}
+	if settings.NumGenerations < 0 {
+		return fmt.Errorf("NumGenerations must be non-negative, got %d", settings.NumGenerations)
+	}
+	return nil
+}
+
+func clampToRange(v float64) float64 {
+	lo, hi := activeBenchmark.LowerBound, activeBenchmark.UpperBound
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// pickThreeDistinct draws three distinct indices from [0, n), all different from excluding.
+func pickThreeDistinct(rng *rand.Rand, n int, excluding int) (int, int, int) {
+	pick := func(taken map[int]bool) int {
+		for {
+			i := rng.Intn(n)
+			if i != excluding && !taken[i] {
+				return i
+			}
+		}
+	}
+	r1 := pick(map[int]bool{})
+	r2 := pick(map[int]bool{r1: true})
+	r3 := pick(map[int]bool{r1: true, r2: true})
+	return r1, r2, r3
+}
+
+// RunDE runs DE/rand/1/bin or DE/best/1/bin (settings.Strategy) to minimize
+// calculate, greedily replacing each target vector with its trial whenever
+// the trial is no worse.
+func RunDE(settings DESettings) (Arg, []float64, error) {
+	if err := validateDESettings(settings); err != nil {
+		return Arg{}, nil, err
+	}
+
+	fitnessHistory := make([]float64, 0, settings.NumGenerations)
+
+	rng := rand.New(rand.NewSource(settings.Seed))
+
+	population := make([]Arg, settings.PopulationSize)
+	fitness := make([]float64, settings.PopulationSize)
+	for i := range population {
+		population[i] = makeNewQuadEntry(makeNewEntry(rng), makeNewEntry(rng))
+		fitness[i] = calculate(population[i])
+	}
+
+	bestIndex := 0
+	for i, f := range fitness {
+		if f < fitness[bestIndex] {
+			bestIndex = i
+		}
+	}
+	best := population[bestIndex]
+	bestFitness := fitness[bestIndex]
+
+	for gen := 0; gen < settings.NumGenerations; gen++ {
+		for i := range population {
+			r1, r2, r3 := pickThreeDistinct(rng, len(population), i)
+
+			base := population[r1]
+			if settings.Strategy == DEBestOneBin {
+				base = best
+			}
+
+			mutantX := clampToRange(base.x + settings.F*(population[r2].x-population[r3].x))
+			mutantY := clampToRange(base.y + settings.F*(population[r2].y-population[r3].y))
+
+			jRand := rng.Intn(2)
+			trial := population[i]
+			if jRand == 0 || rng.Float64() < settings.CR {
+				trial.x = mutantX
+			}
+			if jRand == 1 || rng.Float64() < settings.CR {
+				trial.y = mutantY
+			}
+
+			trialFitness := calculate(trial)
+			if trialFitness <= fitness[i] {
+				population[i] = trial
+				fitness[i] = trialFitness
+				if trialFitness < bestFitness {
+					best = trial
+					bestFitness = trialFitness
+				}
+			}
+		}
+
+		if gen%50 == 0 {
+			fmt.Printf("DE Best: x: %f  y: %f  F(x, y): %f\n", best.x, best.y, bestFitness)
+		}
+		fitnessHistory = append(fitnessHistory, bestFitness)
+	}
+
+	return best, fitnessHistory, nil
+}
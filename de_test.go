@@ -0,0 +1,58 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// newTestRand returns a deterministic *rand.Rand shared by tests that need
+// one, so test failures are reproducible.
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewSource(42))
+}
+
+func TestRunDERejectsTooSmallPopulation(t *testing.T) {
+	for _, populationSize := range []int{0, 1, 2, 3} {
+		settings := DESettings{PopulationSize: populationSize, NumGenerations: 10, F: 0.5, CR: 0.9}
+		if _, _, err := RunDE(settings); err == nil {
+			t.Errorf("RunDE with PopulationSize %d: expected error, got nil", populationSize)
+		}
+	}
+}
+
+func TestRunDERejectsNegativeGenerations(t *testing.T) {
+	settings := DESettings{PopulationSize: 10, NumGenerations: -1, F: 0.5, CR: 0.9}
+	if _, _, err := RunDE(settings); err == nil {
+		t.Error("RunDE with negative NumGenerations: expected error, got nil")
+	}
+}
+
+func TestPickThreeDistinct(t *testing.T) {
+	rng := newTestRand()
+	const n = 5
+	for excluding := 0; excluding < n; excluding++ {
+		r1, r2, r3 := pickThreeDistinct(rng, n, excluding)
+		seen := map[int]bool{excluding: true}
+		for _, r := range []int{r1, r2, r3} {
+			if r < 0 || r >= n {
+				t.Fatalf("index %d out of range [0,%d)", r, n)
+			}
+			if seen[r] {
+				t.Fatalf("indices not distinct: r1=%d r2=%d r3=%d excluding=%d", r1, r2, r3, excluding)
+			}
+			seen[r] = true
+		}
+	}
+}
+
+func TestRunDERunsToCompletion(t *testing.T) {
+	SetBenchmark("booth")
+	settings := DESettings{PopulationSize: 8, NumGenerations: 5, F: 0.8, CR: 0.9, Strategy: DERandOneBin}
+	_, history, err := RunDE(settings)
+	if err != nil {
+		t.Fatalf("RunDE: unexpected error: %v", err)
+	}
+	if len(history) != settings.NumGenerations {
+		t.Errorf("fitnessHistory length = %d, want %d", len(history), settings.NumGenerations)
+	}
+}
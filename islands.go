@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+)
+
+// IslandReport aggregates an island-model run: the best individual found
+// across all islands and each island's per-generation fitness history.
+type IslandReport struct {
+	GlobalBest       Arg
+	PerIslandHistory [][]float64
+}
+
+type island struct {
+	population []Arg
+	best       Arg
+	rng        *rand.Rand
+	history    []float64
+}
+
+// RunIslands spawns numIslands independent populations, each running the
+// same generational loop as Run, advancing them concurrently in goroutines.
+// Every migrationInterval generations, the top migrationSize individuals of
+// island i replace the worst migrationSize individuals of island i+1 (a
+// ring topology). It returns the global best individual and each island's
+// aggregated fitness history.
+func RunIslands(geneticAlgoRunner GeneticAlgorithmRunner, settings GeneticAlgorithmSettings, numIslands, migrationInterval, migrationSize int) (IslandReport, error) {
+	if err := validateSettings(settings); err != nil {
+		return IslandReport{}, err
+	}
+	if numIslands < 1 {
+		return IslandReport{}, fmt.Errorf("numIslands must be positive, got %d", numIslands)
+	}
+	if migrationInterval < 1 {
+		return IslandReport{}, fmt.Errorf("migrationInterval must be positive, got %d", migrationInterval)
+	}
+	if settings.BenchName != "" {
+		if err := SetBenchmark(settings.BenchName); err != nil {
+			return IslandReport{}, err
+		}
+	}
+
+	islands := make([]island, numIslands)
+	for i := range islands {
+		rng := rand.New(rand.NewSource(settings.Seed + int64(i)))
+		population := geneticAlgoRunner.GenerateInitialPopulation(rng, settings.PopulationSize)
+		population = evaluatePopulation(population)
+		geneticAlgoRunner.Sort(population)
+
+		islands[i] = island{
+			population: population,
+			best:       population[len(population)-1],
+			rng:        rng,
+			history:    make([]float64, 0, settings.NumGenerations),
+		}
+	}
+
+	for generationsRun := 0; generationsRun < settings.NumGenerations; {
+		chunk := migrationInterval
+		if generationsRun+chunk > settings.NumGenerations {
+			chunk = settings.NumGenerations - generationsRun
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(numIslands)
+		for i := range islands {
+			go func(i int) {
+				defer wg.Done()
+				population, best, history := advanceGenerations(geneticAlgoRunner, settings, islands[i].rng, islands[i].population, islands[i].best, chunk)
+				islands[i].population = population
+				islands[i].best = best
+				islands[i].history = append(islands[i].history, history...)
+			}(i)
+		}
+		wg.Wait()
+
+		generationsRun += chunk
+		migrateRing(geneticAlgoRunner, islands, migrationSize)
+	}
+
+	globalBest := islands[0].best
+	perIslandHistory := make([][]float64, numIslands)
+	for i := range islands {
+		perIslandHistory[i] = islands[i].history
+		if islands[i].best.fitness < globalBest.fitness {
+			globalBest = islands[i].best
+		}
+	}
+
+	return IslandReport{GlobalBest: globalBest, PerIslandHistory: perIslandHistory}, nil
+}
+
+// migrateRing sends each island's best migrationSize individuals to the
+// next island in the ring, replacing that island's worst migrationSize
+// individuals.
+func migrateRing(geneticAlgoRunner GeneticAlgorithmRunner, islands []island, migrationSize int) {
+	numIslands := len(islands)
+	if migrationSize <= 0 || numIslands < 2 {
+		return
+	}
+
+	emigrants := make([][]Arg, numIslands)
+	for i := range islands {
+		geneticAlgoRunner.Sort(islands[i].population)
+		n := migrationSize
+		if n > len(islands[i].population) {
+			n = len(islands[i].population)
+		}
+		emigrants[i] = append([]Arg(nil), islands[i].population[len(islands[i].population)-n:]...)
+	}
+
+	for i := range islands {
+		incoming := emigrants[(i-1+numIslands)%numIslands]
+		population := islands[i].population
+		for j, migrant := range incoming {
+			population[j] = migrant
+		}
+
+		islands[i].population = evaluatePopulation(population)
+		geneticAlgoRunner.Sort(islands[i].population)
+		islands[i].best = islands[i].population[len(islands[i].population)-1]
+	}
+}
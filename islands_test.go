@@ -0,0 +1,140 @@
+package main
+
+import "testing"
+
+// newIslandFromCoords builds an island whose population is the given (x,y)
+// points, evaluated against the active benchmark and sorted so
+// population[len-1] is the best (lowest fitness), matching GA.Sort. Since
+// migrateRing re-evaluates fitness after migration, tests must use real
+// coordinates rather than hand-picked fitness values.
+func newIslandFromCoords(coords [][2]float64) island {
+	population := make([]Arg, len(coords))
+	for i, c := range coords {
+		population[i] = makeNewQuadEntry(c[0], c[1])
+	}
+	population = evaluatePopulation(population)
+	ga := GA{}
+	ga.Sort(population)
+	return island{population: population, best: population[len(population)-1]}
+}
+
+func TestMigrateRingMovesBestToNextIslandsWorstSlot(t *testing.T) {
+	SetBenchmark("booth")
+	ga := GA{}
+
+	// (1,3) is booth's exact optimum (fitness 0): island 0's best.
+	islands := []island{
+		newIslandFromCoords([][2]float64{{5, 5}, {0, 0}, {1, 3}}),
+		newIslandFromCoords([][2]float64{{8, 8}, {9, 9}, {2, 2}}),
+	}
+
+	migrateRing(ga, islands, 1)
+
+	found := false
+	for _, individual := range islands[1].population {
+		if individual.x == 1 && individual.y == 3 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("island 0's best (1,3) did not migrate into island 1, got %+v", islands[1].population)
+	}
+	for _, individual := range islands[1].population {
+		if individual.x == 9 && individual.y == 9 {
+			t.Errorf("island 1's worst individual (9,9) should have been replaced, still present: %+v", islands[1].population)
+		}
+	}
+}
+
+func TestMigrateRingWrapsAroundTheRing(t *testing.T) {
+	SetBenchmark("booth")
+	ga := GA{}
+
+	islands := []island{
+		newIslandFromCoords([][2]float64{{8, 8}, {9, 9}, {2, 2}}),
+		newIslandFromCoords([][2]float64{{5, 5}, {0, 0}, {1, 3}}),
+	}
+
+	migrateRing(ga, islands, 1)
+
+	found := false
+	for _, individual := range islands[0].population {
+		if individual.x == 1 && individual.y == 3 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("island 1's best (1,3) did not migrate into island 0 via the ring wraparound, got %+v", islands[0].population)
+	}
+}
+
+func TestMigrateRingNoOpForSingleIslandOrZeroSize(t *testing.T) {
+	SetBenchmark("booth")
+	ga := GA{}
+
+	single := []island{newIslandFromCoords([][2]float64{{5, 5}, {0, 0}, {1, 3}})}
+	before := append([]Arg(nil), single[0].population...)
+	migrateRing(ga, single, 1)
+	if len(single[0].population) != len(before) {
+		t.Fatalf("migrateRing with one island should be a no-op, got %+v", single[0].population)
+	}
+
+	pair := []island{
+		newIslandFromCoords([][2]float64{{5, 5}, {0, 0}, {1, 3}}),
+		newIslandFromCoords([][2]float64{{8, 8}, {9, 9}, {2, 2}}),
+	}
+	beforePair := append([]Arg(nil), pair[1].population...)
+	migrateRing(ga, pair, 0)
+	for i, individual := range pair[1].population {
+		if individual != beforePair[i] {
+			t.Fatalf("migrateRing with migrationSize 0 should be a no-op, got %+v", pair[1].population)
+		}
+	}
+}
+
+func TestRunIslandsRejectsInvalidTopology(t *testing.T) {
+	SetBenchmark("booth")
+	settings := GeneticAlgorithmSettings{PopulationSize: 10, MutationRate: 2, CrossoverRate: 100, NumGenerations: 3, Seed: 1}
+	ga := GA{}
+
+	if _, err := RunIslands(ga, settings, 0, 1, 1); err == nil {
+		t.Error("RunIslands with numIslands 0: expected error, got nil")
+	}
+	if _, err := RunIslands(ga, settings, 2, 0, 1); err == nil {
+		t.Error("RunIslands with migrationInterval 0: expected error, got nil")
+	}
+}
+
+func TestRunIslandsSetsBenchmarkFromSettings(t *testing.T) {
+	defer SetBenchmark("beale")
+	SetBenchmark("beale")
+
+	settings := GeneticAlgorithmSettings{PopulationSize: 10, MutationRate: 2, CrossoverRate: 100, NumGenerations: 3, Seed: 1, BenchName: "booth"}
+	ga := GA{}
+
+	if _, err := RunIslands(ga, settings, 2, 1, 1); err != nil {
+		t.Fatalf("RunIslands: unexpected error: %v", err)
+	}
+	if activeBenchmark.Name != "booth" {
+		t.Errorf("after RunIslands with BenchName %q, activeBenchmark.Name = %q", settings.BenchName, activeBenchmark.Name)
+	}
+}
+
+func TestRunIslandsRunsToCompletion(t *testing.T) {
+	SetBenchmark("booth")
+	settings := GeneticAlgorithmSettings{PopulationSize: 10, MutationRate: 2, CrossoverRate: 100, NumGenerations: 6, Seed: 1}
+	ga := GA{}
+
+	report, err := RunIslands(ga, settings, 3, 2, 1)
+	if err != nil {
+		t.Fatalf("RunIslands: unexpected error: %v", err)
+	}
+	if len(report.PerIslandHistory) != 3 {
+		t.Fatalf("len(PerIslandHistory) = %d, want 3", len(report.PerIslandHistory))
+	}
+	for i, history := range report.PerIslandHistory {
+		if len(history) != settings.NumGenerations {
+			t.Errorf("island %d: len(history) = %d, want %d", i, len(history), settings.NumGenerations)
+		}
+	}
+}
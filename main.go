@@ -11,54 +11,87 @@ import (
 	"image"
 	"image/color"
 	"image/png"
-	"math"
 	"math/rand"
 	"sort"
 )
 
 type GeneticAlgorithmSettings struct {
-	PopulationSize           int
+	PopulationSize int
+	// MutationRate and CrossoverRate are percentages in [0,100].
 	MutationRate             int
 	CrossoverRate            int
 	NumGenerations           int
 	KeepBestAcrossPopulation bool
+	Selector                 Selector
+	BenchName                string
+	// Seed makes a run reproducible: the same Seed with the same settings
+	// always explores the same sequence of populations.
+	Seed int64
+}
+
+func validateSettings(settings GeneticAlgorithmSettings) error {
+	if settings.PopulationSize <= 0 {
+		return fmt.Errorf("PopulationSize must be positive, got %d", settings.PopulationSize)
+	}
+	if settings.MutationRate < 0 || settings.MutationRate > 100 {
+		return fmt.Errorf("MutationRate must be a percentage in [0,100], got %d", settings.MutationRate)
+	}
+	if settings.CrossoverRate < 0 || settings.CrossoverRate > 100 {
+		return fmt.Errorf("CrossoverRate must be a percentage in [0,100], got %d", settings.CrossoverRate)
+	}
+	if settings.NumGenerations < 0 {
+		return fmt.Errorf("NumGenerations must be non-negative, got %d", settings.NumGenerations)
+	}
+	return nil
 }
 
 type GeneticAlgorithmRunner interface {
-	GenerateInitialPopulation(populationSize int) []Arg
-	PerformCrossover(individual1, individual2 Arg, crossoverRate int) Arg
-	PerformMutation(individual Arg, mutationRate int) Arg
+	GenerateInitialPopulation(rng *rand.Rand, populationSize int) []Arg
+	PerformCrossover(rng *rand.Rand, individual1, individual2 Arg, crossoverRate int) Arg
+	PerformMutation(rng *rand.Rand, individual Arg, mutationRate int) Arg
 	Sort([]Arg)
 }
 
-func createStochasticProbableListOfIndividuals(population []Arg) []Arg {
-	totalCount, populationLength := 0, len(population)
-	for j := 0; j < populationLength; j++ {
-		totalCount += j
+func Run(geneticAlgoRunner GeneticAlgorithmRunner, settings GeneticAlgorithmSettings) (Arg, []float64, error) {
+	if err := validateSettings(settings); err != nil {
+		return Arg{}, nil, err
 	}
-
-	probableIndividuals := make([]Arg, 0, totalCount)
-	for index, individual := range population {
-		for i := 0; i < index; i++ {
-			probableIndividuals = append(probableIndividuals, individual)
+	if settings.BenchName != "" {
+		if err := SetBenchmark(settings.BenchName); err != nil {
+			return Arg{}, nil, err
 		}
 	}
 
-	return probableIndividuals
-}
+	rng := rand.New(rand.NewSource(settings.Seed))
 
-func Run(geneticAlgoRunner GeneticAlgorithmRunner, settings GeneticAlgorithmSettings) (Arg, []float64, error) {
-	fitnessHistory := make([]float64, 0)
-
-	population := geneticAlgoRunner.GenerateInitialPopulation(settings.PopulationSize)
+	population := geneticAlgoRunner.GenerateInitialPopulation(rng, settings.PopulationSize)
+	population = evaluatePopulation(population)
 
 	bestSoFar := population[len(population)-1]
-	fmt.Printf("First Best: x: %f  y: %f  F(x, y): %f\n", bestSoFar.x, bestSoFar.y, calculate(bestSoFar))
+	fmt.Printf("First Best: x: %f  y: %f  F(x, y): %f\n", bestSoFar.x, bestSoFar.y, bestSoFar.fitness)
 	geneticAlgoRunner.Sort(population)
 
 	bestSoFar = population[len(population)-1]
-	fmt.Printf("First Best: x: %f  y: %f  F(x, y): %f\n", bestSoFar.x, bestSoFar.y, calculate(bestSoFar))
-	for i := 0; i < settings.NumGenerations; i++ {
+	fmt.Printf("First Best: x: %f  y: %f  F(x, y): %f\n", bestSoFar.x, bestSoFar.y, bestSoFar.fitness)
+
+	_, bestSoFar, fitnessHistory := advanceGenerations(geneticAlgoRunner, settings, rng, population, bestSoFar, settings.NumGenerations)
+
+	return bestSoFar, fitnessHistory, nil
+}
+
+// advanceGenerations runs numGenerations of the generational loop starting
+// from population, returning the ending population, the best individual
+// found, and the per-generation best-fitness history. It is shared by Run
+// and RunIslands, which advances several populations in lockstep between
+// migrations.
+func advanceGenerations(geneticAlgoRunner GeneticAlgorithmRunner, settings GeneticAlgorithmSettings, rng *rand.Rand, population []Arg, bestSoFar Arg, numGenerations int) ([]Arg, Arg, []float64) {
+	selector := settings.Selector
+	if selector == nil {
+		selector = TournamentSelector{K: 3}
+	}
+
+	fitnessHistory := make([]float64, 0, numGenerations)
+	for i := 0; i < numGenerations; i++ {
 
 		newPopulation := make([]Arg, 0, settings.PopulationSize)
 
@@ -66,31 +99,24 @@ func Run(geneticAlgoRunner GeneticAlgorithmRunner, settings GeneticAlgorithmSett
 			newPopulation = append(newPopulation, bestSoFar)
 		}
 
-		// perform crossovers with random selection
-		probabilisticListOfPerformers := createStochasticProbableListOfIndividuals(population)
-
 		newPopIndex := 0
 		if settings.KeepBestAcrossPopulation {
 			newPopIndex = 1
 		}
 		for ; newPopIndex < settings.PopulationSize; newPopIndex++ {
-			indexSelection1 := rand.Int() % len(probabilisticListOfPerformers)
-			indexSelection2 := rand.Int() % len(probabilisticListOfPerformers)
-
-			// crossover
-			newIndividual := geneticAlgoRunner.PerformCrossover(
-				probabilisticListOfPerformers[indexSelection1],
-				probabilisticListOfPerformers[indexSelection2], settings.CrossoverRate)
+			// perform crossover with a selected pair of parents
+			parents := selector.Select(rng, population, 2)
+			newIndividual := geneticAlgoRunner.PerformCrossover(rng, parents[0], parents[1], settings.CrossoverRate)
 
 			// mutate
-			if rand.Intn(101) < settings.MutationRate {
-				newIndividual = geneticAlgoRunner.PerformMutation(newIndividual, settings.MutationRate)
+			if rng.Intn(100) < settings.MutationRate {
+				newIndividual = geneticAlgoRunner.PerformMutation(rng, newIndividual, settings.MutationRate)
 			}
 
 			newPopulation = append(newPopulation, newIndividual)
 		}
 
-		population = newPopulation
+		population = evaluatePopulation(newPopulation)
 
 		// sort by performance
 		geneticAlgoRunner.Sort(population)
@@ -98,21 +124,21 @@ func Run(geneticAlgoRunner GeneticAlgorithmRunner, settings GeneticAlgorithmSett
 		// keep the best so far
 		bestSoFar = population[len(population)-1]
 		if i%50 == 0 {
-			fmt.Printf("Best: x: %f  y: %f  F(x, y): %f\n", bestSoFar.x, bestSoFar.y, calculate(bestSoFar))
+			fmt.Printf("Best: x: %f  y: %f  F(x, y): %f\n", bestSoFar.x, bestSoFar.y, bestSoFar.fitness)
 		}
-		fitnessHistory = append(fitnessHistory, calculate(bestSoFar))
+		fitnessHistory = append(fitnessHistory, bestSoFar.fitness)
 	}
-	return bestSoFar, fitnessHistory, nil
+	return population, bestSoFar, fitnessHistory
 }
 
 type Arg struct {
-	x, y float64
+	x, y    float64
+	fitness float64
 }
 
-const highRange = 100.0
-
-func makeNewEntry() float64 {
-	return highRange * rand.Float64()
+func makeNewEntry(rng *rand.Rand) float64 {
+	lo, hi := activeBenchmark.LowerBound, activeBenchmark.UpperBound
+	return lo + (hi-lo)*rng.Float64()
 }
 
 func makeNewQuadEntry(newX, newY float64) Arg {
@@ -123,66 +149,82 @@ func makeNewQuadEntry(newX, newY float64) Arg {
 }
 
 func calculate(entry Arg) float64 {
-	//booth (1;3) 0
-	//a := entry.x + 2*entry.y - 7
-	//b := 2*entry.x + entry.y - 5
-	//return a*a + b*b
-
-	//camel (0;0) 0
-	// return 2*entry.x*entry.x - 1.05*math.Pow(entry.x, 4) + math.Pow(entry.x, 6)/6 + entry.x*entry.y + entry.y*entry.y
-
-	//bill's (3;0.5) 0
-	return math.Pow(1.5-entry.x+entry.x*entry.y, 2) + math.Pow(2.25-entry.x+math.Pow(entry.x*entry.y, 2), 2) + math.Pow(2.625-entry.x+math.Pow(entry.x*entry.y, 3), 2)
+	return activeBenchmark.Func(entry)
 }
 
 type GA struct {
+	Crossover CrossoverOperator
+	Mutation  MutationOperator
 }
 
-func (l GA) GenerateInitialPopulation(populationSize int) []Arg {
+func (l GA) GenerateInitialPopulation(rng *rand.Rand, populationSize int) []Arg {
 
 	initialPopulation := make([]Arg, 0, populationSize)
 	for i := 0; i < populationSize; i++ {
-		initialPopulation = append(initialPopulation, makeNewQuadEntry(makeNewEntry(), makeNewEntry()))
+		initialPopulation = append(initialPopulation, makeNewQuadEntry(makeNewEntry(rng), makeNewEntry(rng)))
 	}
 
 	return initialPopulation
 }
-func (l GA) PerformCrossover(result1, result2 Arg, _ int) Arg {
-	return makeNewQuadEntry(
-		(result1.x+result2.x)/2,
-		(result1.y+result2.y)/2,
-	)
+func (l GA) PerformCrossover(rng *rand.Rand, result1, result2 Arg, _ int) Arg {
+	if l.Crossover == nil {
+		return makeNewQuadEntry(
+			(result1.x+result2.x)/2,
+			(result1.y+result2.y)/2,
+		)
+	}
+	return l.Crossover.Crossover(rng, result1, result2)
 }
-func (l GA) PerformMutation(_ Arg, _ int) Arg {
-	return makeNewQuadEntry(makeNewEntry(), makeNewEntry())
+func (l GA) PerformMutation(rng *rand.Rand, individual Arg, _ int) Arg {
+	if l.Mutation == nil {
+		return makeNewQuadEntry(makeNewEntry(rng), makeNewEntry(rng))
+	}
+	return l.Mutation.Mutate(rng, individual)
 }
 func (l GA) Sort(population []Arg) {
 	sort.Slice(population, func(i, j int) bool {
-		return calculate(population[i]) > calculate(population[j])
+		return population[i].fitness > population[j].fitness
 	})
 }
 
 func argMain() {
 	settings := GeneticAlgorithmSettings{
 		PopulationSize:           100,
-		MutationRate:             102,
+		MutationRate:             2,
 		CrossoverRate:            100,
 		NumGenerations:           1000,
 		KeepBestAcrossPopulation: true,
+		Selector:                 TournamentSelector{K: 3},
+		BenchName:                "beale",
+		Seed:                     1,
 	}
 
-	best, fitnessHistory, err := Run(GA{}, settings)
-	if err != nil {
-		println(err)
-	} else {
-		fmt.Printf("Best: x: %f  y: %f  F(x, y): %f\n", best.x, best.y, calculate(best))
-	}
+	// run a sweep, emitting one convergence plot per benchmark
+	for _, benchName := range BenchmarkNames() {
+		settings.BenchName = benchName
+		if err := SetBenchmark(settings.BenchName); err != nil {
+			fmt.Println(err)
+			continue
+		}
 
-	img, err := createLineChart(fitnessHistory)
-	if err != nil {
-		fmt.Println(err)
+		ga := GA{
+			Crossover: SBXCrossover{Eta: 20},
+			Mutation:  PolynomialMutation{Eta: 20},
+		}
+		best, fitnessHistory, err := Run(ga, settings)
+		if err != nil {
+			println(err)
+			continue
+		}
+		fmt.Printf("%s Best: x: %f  y: %f  F(x, y): %f\n", benchName, best.x, best.y, best.fitness)
+
+		img, err := createLineChart(fitnessHistory)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		printImage(img)
 	}
-	printImage(img)
 }
 func main() {
 	// Time := time.Now()
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+)
+
+// CrossoverOperator produces one child from two parents. Concrete
+// implementations replace GA's default midpoint-average crossover.
+type CrossoverOperator interface {
+	Crossover(rng *rand.Rand, parent1, parent2 Arg) Arg
+}
+
+// MutationOperator perturbs a single individual. Concrete implementations
+// replace GA's default full-resample mutation.
+type MutationOperator interface {
+	Mutate(rng *rand.Rand, individual Arg) Arg
+}
+
+// BLXAlphaCrossover draws each child coordinate uniformly from
+// [min(p1,p2)-alpha*d, max(p1,p2)+alpha*d] where d = |p1-p2|. Alpha≈0.5 is
+// the commonly recommended default.
+type BLXAlphaCrossover struct {
+	Alpha float64
+}
+
+func (b BLXAlphaCrossover) Crossover(rng *rand.Rand, parent1, parent2 Arg) Arg {
+	return makeNewQuadEntry(
+		blxAlphaCoord(rng, parent1.x, parent2.x, b.Alpha),
+		blxAlphaCoord(rng, parent1.y, parent2.y, b.Alpha),
+	)
+}
+
+func blxAlphaCoord(rng *rand.Rand, a, b, alpha float64) float64 {
+	lo, hi := a, b
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	d := hi - lo
+	min := lo - alpha*d
+	max := hi + alpha*d
+	return clampToRange(min + rng.Float64()*(max-min))
+}
+
+// SBXCrossover implements simulated binary crossover with distribution
+// index Eta: higher Eta keeps children closer to the parents. SBX produces
+// two children per coordinate; one of the pair is picked at random to fit
+// PerformCrossover's single-child signature.
+type SBXCrossover struct {
+	Eta float64
+}
+
+func (s SBXCrossover) Crossover(rng *rand.Rand, parent1, parent2 Arg) Arg {
+	x1, x2 := sbxCoord(rng, parent1.x, parent2.x, s.Eta)
+	y1, y2 := sbxCoord(rng, parent1.y, parent2.y, s.Eta)
+	if rng.Float64() < 0.5 {
+		return makeNewQuadEntry(x1, y1)
+	}
+	return makeNewQuadEntry(x2, y2)
+}
+
+func sbxCoord(rng *rand.Rand, x1, x2, eta float64) (float64, float64) {
+	u := rng.Float64()
+
+	var beta float64
+	if u <= 0.5 {
+		beta = math.Pow(2*u, 1/(eta+1))
+	} else {
+		beta = math.Pow(1/(2*(1-u)), 1/(eta+1))
+	}
+
+	child1 := 0.5 * ((1+beta)*x1 + (1-beta)*x2)
+	child2 := 0.5 * ((1-beta)*x1 + (1+beta)*x2)
+	return clampToRange(child1), clampToRange(child2)
+}
+
+// PolynomialMutation perturbs a coordinate by a delta derived from a
+// uniform draw u and the distribution index Eta, scaled to the active
+// benchmark's domain width.
+type PolynomialMutation struct {
+	Eta float64
+}
+
+func (m PolynomialMutation) Mutate(rng *rand.Rand, individual Arg) Arg {
+	return makeNewQuadEntry(
+		polynomialCoord(rng, individual.x, m.Eta),
+		polynomialCoord(rng, individual.y, m.Eta),
+	)
+}
+
+func polynomialCoord(rng *rand.Rand, x, eta float64) float64 {
+	lo, hi := activeBenchmark.LowerBound, activeBenchmark.UpperBound
+	u := rng.Float64()
+
+	var delta float64
+	if u < 0.5 {
+		delta = math.Pow(2*u, 1/(eta+1)) - 1
+	} else {
+		delta = 1 - math.Pow(2*(1-u), 1/(eta+1))
+	}
+
+	return clampToRange(x + delta*(hi-lo))
+}
+
+// GaussianMutation adds N(0, Sigma) noise to each coordinate and clamps the
+// result to the active benchmark's bounds.
+type GaussianMutation struct {
+	Sigma float64
+}
+
+func (m GaussianMutation) Mutate(rng *rand.Rand, individual Arg) Arg {
+	return makeNewQuadEntry(
+		clampToRange(individual.x+rng.NormFloat64()*m.Sigma),
+		clampToRange(individual.y+rng.NormFloat64()*m.Sigma),
+	)
+}
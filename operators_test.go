@@ -0,0 +1,64 @@
+package main
+
+import "testing"
+
+func withinBounds(t *testing.T, label string, v float64) {
+	t.Helper()
+	if v < activeBenchmark.LowerBound || v > activeBenchmark.UpperBound {
+		t.Errorf("%s: %v outside [%v, %v]", label, v, activeBenchmark.LowerBound, activeBenchmark.UpperBound)
+	}
+}
+
+func TestBLXAlphaCrossoverStaysInBounds(t *testing.T) {
+	SetBenchmark("booth")
+	rng := newTestRand()
+	p1 := makeNewQuadEntry(1, 3)
+	p2 := makeNewQuadEntry(-2, 8)
+
+	op := BLXAlphaCrossover{Alpha: 0.5}
+	for i := 0; i < 50; i++ {
+		child := op.Crossover(rng, p1, p2)
+		withinBounds(t, "BLXAlphaCrossover.x", child.x)
+		withinBounds(t, "BLXAlphaCrossover.y", child.y)
+	}
+}
+
+func TestSBXCrossoverStaysInBounds(t *testing.T) {
+	SetBenchmark("booth")
+	rng := newTestRand()
+	p1 := makeNewQuadEntry(1, 3)
+	p2 := makeNewQuadEntry(-2, 8)
+
+	op := SBXCrossover{Eta: 20}
+	for i := 0; i < 50; i++ {
+		child := op.Crossover(rng, p1, p2)
+		withinBounds(t, "SBXCrossover.x", child.x)
+		withinBounds(t, "SBXCrossover.y", child.y)
+	}
+}
+
+func TestPolynomialMutationStaysInBounds(t *testing.T) {
+	SetBenchmark("booth")
+	rng := newTestRand()
+	individual := makeNewQuadEntry(1, 3)
+
+	op := PolynomialMutation{Eta: 20}
+	for i := 0; i < 50; i++ {
+		mutated := op.Mutate(rng, individual)
+		withinBounds(t, "PolynomialMutation.x", mutated.x)
+		withinBounds(t, "PolynomialMutation.y", mutated.y)
+	}
+}
+
+func TestGaussianMutationStaysInBounds(t *testing.T) {
+	SetBenchmark("booth")
+	rng := newTestRand()
+	individual := makeNewQuadEntry(1, 3)
+
+	op := GaussianMutation{Sigma: 5}
+	for i := 0; i < 50; i++ {
+		mutated := op.Mutate(rng, individual)
+		withinBounds(t, "GaussianMutation.x", mutated.x)
+		withinBounds(t, "GaussianMutation.y", mutated.y)
+	}
+}
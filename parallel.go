@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// evaluatePopulation computes calculate once per individual, caching it on
+// Arg.fitness, spreading the work across a worker pool sized to
+// runtime.NumCPU() so later sorting/selection can read the cached value
+// instead of recomputing it.
+func evaluatePopulation(population []Arg) []Arg {
+	numWorkers := runtime.NumCPU()
+	if numWorkers > len(population) {
+		numWorkers = len(population)
+	}
+
+	jobs := make(chan int, len(population))
+	var wg sync.WaitGroup
+	for w := 0; w < numWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				population[i].fitness = calculate(population[i])
+			}
+		}()
+	}
+
+	for i := range population {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return population
+}
+
+// GenerationStats summarizes one generation's best fitness across multiple
+// independent runs.
+type GenerationStats struct {
+	Mean   float64
+	Median float64
+	StdDev float64
+	Best   float64
+	Worst  float64
+}
+
+func computeGenerationStats(values []float64) GenerationStats {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	n := len(sorted)
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	mean := sum / float64(n)
+
+	var median float64
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	} else {
+		median = sorted[n/2]
+	}
+
+	varSum := 0.0
+	for _, v := range sorted {
+		d := v - mean
+		varSum += d * d
+	}
+	stdDev := math.Sqrt(varSum / float64(n))
+
+	return GenerationStats{
+		Mean:   mean,
+		Median: median,
+		StdDev: stdDev,
+		Best:   sorted[0],
+		Worst:  sorted[n-1],
+	}
+}
+
+// RunMany runs the GA repetitions times with independent seeds and returns
+// per-generation mean, median, stddev, and best/worst fitness across runs,
+// the standard way evolutionary algorithms are reported in the literature.
+func RunMany(geneticAlgoRunner GeneticAlgorithmRunner, settings GeneticAlgorithmSettings, repetitions int) ([]GenerationStats, error) {
+	if err := validateSettings(settings); err != nil {
+		return nil, err
+	}
+	if repetitions < 1 {
+		return nil, fmt.Errorf("repetitions must be at least 1, got %d", repetitions)
+	}
+
+	histories := make([][]float64, repetitions)
+	for r := 0; r < repetitions; r++ {
+		runSettings := settings
+		runSettings.Seed = settings.Seed + int64(r)
+
+		_, history, err := Run(geneticAlgoRunner, runSettings)
+		if err != nil {
+			return nil, err
+		}
+		histories[r] = history
+	}
+
+	stats := make([]GenerationStats, settings.NumGenerations)
+	values := make([]float64, repetitions)
+	for gen := 0; gen < settings.NumGenerations; gen++ {
+		for r := 0; r < repetitions; r++ {
+			values[r] = histories[r][gen]
+		}
+		stats[gen] = computeGenerationStats(values)
+	}
+
+	return stats, nil
+}
+
+// createConvergenceBandChart plots the mean best-fitness curve across runs
+// with a shaded band spanning the min (Best) to max (Worst) fitness seen at
+// each generation.
+func createConvergenceBandChart(stats []GenerationStats) (image.Image, error) {
+	p := plot.New()
+
+	p.X.Label.Text = "Gens"
+	p.Y.Label.Text = "Fitness"
+	p.Add(plotter.NewGrid())
+
+	n := len(stats)
+	band := make(plotter.XYs, 2*n)
+	for i, s := range stats {
+		band[i].X = float64(i)
+		band[i].Y = s.Worst
+	}
+	for i := 0; i < n; i++ {
+		s := stats[n-1-i]
+		band[n+i].X = float64(n - 1 - i)
+		band[n+i].Y = s.Best
+	}
+	bandPoly, err := plotter.NewPolygon(band)
+	if err != nil {
+		return nil, err
+	}
+	bandPoly.Color = color.RGBA{R: 255, G: 200, B: 200, A: 128}
+	bandPoly.LineStyle.Width = 0
+	p.Add(bandPoly)
+
+	meanLine := make(plotter.XYs, n)
+	for i, s := range stats {
+		meanLine[i].X = float64(i)
+		meanLine[i].Y = s.Mean
+	}
+	line, err := plotter.NewLine(meanLine)
+	if err != nil {
+		return nil, err
+	}
+	line.Color = color.RGBA{R: 255, A: 255}
+	p.Add(line)
+
+	canvas := vgimg.New(800, 400)
+	p.Draw(draw.New(canvas))
+	return canvas.Image(), nil
+}
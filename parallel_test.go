@@ -0,0 +1,64 @@
+package main
+
+import "testing"
+
+func TestRunManyRejectsNonPositiveRepetitions(t *testing.T) {
+	SetBenchmark("booth")
+	settings := GeneticAlgorithmSettings{PopulationSize: 10, MutationRate: 2, CrossoverRate: 100, NumGenerations: 3, Seed: 1}
+	ga := GA{}
+
+	for _, repetitions := range []int{0, -1} {
+		if _, err := RunMany(ga, settings, repetitions); err == nil {
+			t.Errorf("RunMany with repetitions %d: expected error, got nil", repetitions)
+		}
+	}
+}
+
+func TestRunManyRejectsInvalidSettings(t *testing.T) {
+	SetBenchmark("booth")
+	settings := GeneticAlgorithmSettings{PopulationSize: 0, MutationRate: 2, CrossoverRate: 100, NumGenerations: 3, Seed: 1}
+	ga := GA{}
+
+	if _, err := RunMany(ga, settings, 3); err == nil {
+		t.Error("RunMany with PopulationSize 0: expected error, got nil")
+	}
+}
+
+func TestRunManyAggregatesStats(t *testing.T) {
+	SetBenchmark("booth")
+	settings := GeneticAlgorithmSettings{PopulationSize: 10, MutationRate: 2, CrossoverRate: 100, NumGenerations: 4, Seed: 1}
+	ga := GA{}
+
+	stats, err := RunMany(ga, settings, 3)
+	if err != nil {
+		t.Fatalf("RunMany: unexpected error: %v", err)
+	}
+	if len(stats) != settings.NumGenerations {
+		t.Fatalf("len(stats) = %d, want %d", len(stats), settings.NumGenerations)
+	}
+	for gen, s := range stats {
+		if s.Best > s.Mean || s.Mean > s.Worst {
+			t.Errorf("generation %d: expected Best <= Mean <= Worst, got %+v", gen, s)
+		}
+	}
+}
+
+func TestComputeGenerationStats(t *testing.T) {
+	stats := computeGenerationStats([]float64{4, 2, 8, 6})
+
+	if stats.Best != 2 {
+		t.Errorf("Best = %v, want 2", stats.Best)
+	}
+	if stats.Worst != 8 {
+		t.Errorf("Worst = %v, want 8", stats.Worst)
+	}
+	if stats.Mean != 5 {
+		t.Errorf("Mean = %v, want 5", stats.Mean)
+	}
+	if stats.Median != 5 {
+		t.Errorf("Median = %v, want 5", stats.Median)
+	}
+	if stats.StdDev <= 0 {
+		t.Errorf("StdDev = %v, want > 0", stats.StdDev)
+	}
+}
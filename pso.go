@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Particle holds one swarm member's position, velocity, and personal best.
+type Particle struct {
+	position Arg
+	velocity Arg
+
+	bestPosition Arg
+	bestFitness  float64
+}
+
+type PSOSettings struct {
+	SwarmSize  int
+	Iterations int
+	W          float64 // inertia weight
+	C1         float64 // cognitive (personal-best) coefficient
+	C2         float64 // social (global-best) coefficient
+	VMax       float64 // velocity clamp, applied per dimension
+	Seed       int64
+}
+
+// validatePSOSettings checks that settings describe a runnable swarm.
+func validatePSOSettings(settings PSOSettings) error {
+	if settings.SwarmSize <= 0 {
+		return fmt.Errorf("SwarmSize must be positive, got %d", settings.SwarmSize)
+	}
+	if settings.Iterations < 0 {
+		return fmt.Errorf("Iterations must be non-negative, got %d", settings.Iterations)
+	}
+	return nil
+}
+
+func clampVelocity(v, vMax float64) float64 {
+	if v > vMax {
+		return vMax
+	}
+	if v < -vMax {
+		return -vMax
+	}
+	return v
+}
+
+// updateVelocity computes a particle's next velocity from its personal best
+// and the swarm's global best, drawing rp and rg independently for each
+// dimension (per U(0,1)); reusing one draw across x and y would correlate
+// the two dimensions and bias the swarm toward diagonal movement. The
+// result is clamped to settings.VMax per dimension.
+func updateVelocity(rng *rand.Rand, velocity, position, bestPosition, globalBest Arg, settings PSOSettings) Arg {
+	rpX, rgX := rng.Float64(), rng.Float64()
+	rpY, rgY := rng.Float64(), rng.Float64()
+
+	vx := settings.W*velocity.x + settings.C1*rpX*(bestPosition.x-position.x) + settings.C2*rgX*(globalBest.x-position.x)
+	vy := settings.W*velocity.y + settings.C1*rpY*(bestPosition.y-position.y) + settings.C2*rgY*(globalBest.y-position.y)
+
+	return Arg{x: clampVelocity(vx, settings.VMax), y: clampVelocity(vy, settings.VMax)}
+}
+
+// RunPSO runs particle swarm optimization to minimize calculate, updating
+// each particle's velocity from its personal best and the swarm's global
+// best.
+func RunPSO(settings PSOSettings) (Arg, []float64, error) {
+	if err := validatePSOSettings(settings); err != nil {
+		return Arg{}, nil, err
+	}
+
+	fitnessHistory := make([]float64, 0, settings.Iterations)
+
+	rng := rand.New(rand.NewSource(settings.Seed))
+
+	swarm := make([]Particle, settings.SwarmSize)
+	for i := range swarm {
+		position := makeNewQuadEntry(makeNewEntry(rng), makeNewEntry(rng))
+		fitness := calculate(position)
+		swarm[i] = Particle{
+			position:     position,
+			velocity:     Arg{x: 0, y: 0},
+			bestPosition: position,
+			bestFitness:  fitness,
+		}
+	}
+
+	globalBest := swarm[0].bestPosition
+	globalBestFitness := swarm[0].bestFitness
+	for _, p := range swarm[1:] {
+		if p.bestFitness < globalBestFitness {
+			globalBest = p.bestPosition
+			globalBestFitness = p.bestFitness
+		}
+	}
+
+	for iter := 0; iter < settings.Iterations; iter++ {
+		for i := range swarm {
+			p := &swarm[i]
+
+			p.velocity = updateVelocity(rng, p.velocity, p.position, p.bestPosition, globalBest, settings)
+
+			p.position.x = clampToRange(p.position.x + p.velocity.x)
+			p.position.y = clampToRange(p.position.y + p.velocity.y)
+
+			fitness := calculate(p.position)
+			if fitness < p.bestFitness {
+				p.bestPosition = p.position
+				p.bestFitness = fitness
+				if fitness < globalBestFitness {
+					globalBest = p.position
+					globalBestFitness = fitness
+				}
+			}
+		}
+
+		if iter%50 == 0 {
+			fmt.Printf("PSO Best: x: %f  y: %f  F(x, y): %f\n", globalBest.x, globalBest.y, globalBestFitness)
+		}
+		fitnessHistory = append(fitnessHistory, globalBestFitness)
+	}
+
+	return globalBest, fitnessHistory, nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestUpdateVelocityDrawsIndependentPerDimensionRandoms is a regression test
+// for reusing a single rp/rg draw across both dimensions, which would
+// correlate velocity.x and velocity.y and bias the swarm toward diagonal
+// movement. It recomputes the expected velocity by hand, consuming four
+// independent Float64 draws (rpX, rgX, rpY, rgY) from an identically seeded
+// rng, and fails if updateVelocity consumes only two.
+func TestUpdateVelocityDrawsIndependentPerDimensionRandoms(t *testing.T) {
+	settings := PSOSettings{W: 0.7, C1: 1.5, C2: 1.5, VMax: 100}
+	velocity := Arg{x: 0, y: 0}
+	position := Arg{x: 1, y: 5}
+	bestPosition := Arg{x: 2, y: 1}
+	globalBest := Arg{x: 4, y: -3}
+
+	expectedRng := rand.New(rand.NewSource(7))
+	rpX, rgX := expectedRng.Float64(), expectedRng.Float64()
+	rpY, rgY := expectedRng.Float64(), expectedRng.Float64()
+	want := Arg{
+		x: settings.W*velocity.x + settings.C1*rpX*(bestPosition.x-position.x) + settings.C2*rgX*(globalBest.x-position.x),
+		y: settings.W*velocity.y + settings.C1*rpY*(bestPosition.y-position.y) + settings.C2*rgY*(globalBest.y-position.y),
+	}
+
+	got := updateVelocity(rand.New(rand.NewSource(7)), velocity, position, bestPosition, globalBest, settings)
+
+	if got != want {
+		t.Errorf("updateVelocity = %+v, want %+v (rp/rg not drawn independently per dimension)", got, want)
+	}
+}
+
+func TestRunPSORejectsNonPositiveSwarmSize(t *testing.T) {
+	for _, swarmSize := range []int{0, -1} {
+		settings := PSOSettings{SwarmSize: swarmSize, Iterations: 5, W: 0.7, C1: 1.5, C2: 1.5, VMax: 1}
+		if _, _, err := RunPSO(settings); err == nil {
+			t.Errorf("RunPSO with SwarmSize %d: expected error, got nil", swarmSize)
+		}
+	}
+}
+
+func TestRunPSORejectsNegativeIterations(t *testing.T) {
+	settings := PSOSettings{SwarmSize: 5, Iterations: -1, W: 0.7, C1: 1.5, C2: 1.5, VMax: 1}
+	if _, _, err := RunPSO(settings); err == nil {
+		t.Error("RunPSO with negative Iterations: expected error, got nil")
+	}
+}
+
+func TestRunPSORunsToCompletion(t *testing.T) {
+	SetBenchmark("booth")
+	settings := PSOSettings{SwarmSize: 8, Iterations: 5, W: 0.7, C1: 1.5, C2: 1.5, VMax: 4}
+	_, history, err := RunPSO(settings)
+	if err != nil {
+		t.Fatalf("RunPSO: unexpected error: %v", err)
+	}
+	if len(history) != settings.Iterations {
+		t.Errorf("fitnessHistory length = %d, want %d", len(history), settings.Iterations)
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Selector picks n individuals from population to act as parents for the
+// next generation. Implementations are free to sample with replacement.
+// rng is the run's seeded generator, so selection stays reproducible.
+type Selector interface {
+	Select(rng *rand.Rand, population []Arg, n int) []Arg
+}
+
+// TournamentSelector runs a k-ary tournament: for each of the n slots it
+// draws K random individuals and keeps the best (lowest fitness value).
+type TournamentSelector struct {
+	K int
+}
+
+func (t TournamentSelector) Select(rng *rand.Rand, population []Arg, n int) []Arg {
+	k := t.K
+	if k < 1 {
+		k = 1
+	}
+	selected := make([]Arg, 0, n)
+	for i := 0; i < n; i++ {
+		best := population[rng.Intn(len(population))]
+		bestFitness := best.fitness
+		for j := 1; j < k; j++ {
+			candidate := population[rng.Intn(len(population))]
+			if f := candidate.fitness; f < bestFitness {
+				best = candidate
+				bestFitness = f
+			}
+		}
+		selected = append(selected, best)
+	}
+	return selected
+}
+
+// fitnessWeights converts minimization fitness values into selection
+// weights, where a smaller fitness yields a larger weight.
+func fitnessWeights(population []Arg) []float64 {
+	maxF := population[0].fitness
+	for _, individual := range population[1:] {
+		if f := individual.fitness; f > maxF {
+			maxF = f
+		}
+	}
+
+	weights := make([]float64, len(population))
+	total := 0.0
+	for i, individual := range population {
+		weights[i] = maxF - individual.fitness
+		total += weights[i]
+	}
+
+	if total <= 0 {
+		for i := range weights {
+			weights[i] = 1
+			total += 1
+		}
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+	return weights
+}
+
+func cumulativeSum(weights []float64) []float64 {
+	cumulative := make([]float64, len(weights))
+	sum := 0.0
+	for i, w := range weights {
+		sum += w
+		cumulative[i] = sum
+	}
+	return cumulative
+}
+
+func pickByCumulative(cumulative []float64, pointer float64) int {
+	for i, c := range cumulative {
+		if pointer <= c {
+			return i
+		}
+	}
+	return len(cumulative) - 1
+}
+
+// RouletteSelector implements fitness-proportionate (roulette wheel)
+// selection, normalized for minimization via maxF - f(x).
+type RouletteSelector struct{}
+
+func (RouletteSelector) Select(rng *rand.Rand, population []Arg, n int) []Arg {
+	cumulative := cumulativeSum(fitnessWeights(population))
+	selected := make([]Arg, 0, n)
+	for i := 0; i < n; i++ {
+		selected = append(selected, population[pickByCumulative(cumulative, rng.Float64())])
+	}
+	return selected
+}
+
+// RankSelector assigns selection probability linearly by rank (best
+// individual ranked highest) rather than raw fitness, which keeps
+// selection pressure stable when fitness values are close together.
+type RankSelector struct{}
+
+func (RankSelector) Select(rng *rand.Rand, population []Arg, n int) []Arg {
+	ranked := make([]Arg, len(population))
+	copy(ranked, population)
+	sort.Slice(ranked, func(i, j int) bool {
+		return ranked[i].fitness < ranked[j].fitness
+	})
+
+	weights := make([]float64, len(ranked))
+	total := 0.0
+	for i := range ranked {
+		weights[i] = float64(len(ranked) - i)
+		total += weights[i]
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+
+	cumulative := cumulativeSum(weights)
+	selected := make([]Arg, 0, n)
+	for i := 0; i < n; i++ {
+		selected = append(selected, ranked[pickByCumulative(cumulative, rng.Float64())])
+	}
+	return selected
+}
+
+// SUSSelector implements stochastic universal sampling: a single spin of
+// the wheel with n equally-spaced pointers, which reduces the selection
+// variance compared to n independent roulette spins.
+type SUSSelector struct{}
+
+func (SUSSelector) Select(rng *rand.Rand, population []Arg, n int) []Arg {
+	cumulative := cumulativeSum(fitnessWeights(population))
+	total := cumulative[len(cumulative)-1]
+
+	step := total / float64(n)
+	start := rng.Float64() * step
+
+	selected := make([]Arg, 0, n)
+	for i := 0; i < n; i++ {
+		pointer := start + float64(i)*step
+		selected = append(selected, population[pickByCumulative(cumulative, pointer)])
+	}
+	return selected
+}
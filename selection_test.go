@@ -0,0 +1,81 @@
+package main
+
+import "testing"
+
+func evaluatedPopulation(coords [][2]float64) []Arg {
+	population := make([]Arg, len(coords))
+	for i, c := range coords {
+		population[i] = makeNewQuadEntry(c[0], c[1])
+	}
+	return evaluatePopulation(population)
+}
+
+func TestTournamentSelectorPicksFromPopulation(t *testing.T) {
+	SetBenchmark("booth")
+	population := evaluatedPopulation([][2]float64{{1, 3}, {0, 0}, {5, 5}, {-2, 8}})
+	rng := newTestRand()
+
+	selected := TournamentSelector{K: 3}.Select(rng, population, 10)
+	if len(selected) != 10 {
+		t.Fatalf("len(selected) = %d, want 10", len(selected))
+	}
+	for _, s := range selected {
+		if !populationContains(population, s) {
+			t.Errorf("selected individual %+v not found in population", s)
+		}
+	}
+}
+
+func TestTournamentSelectorFallsBackToKOne(t *testing.T) {
+	SetBenchmark("booth")
+	population := evaluatedPopulation([][2]float64{{1, 3}, {0, 0}})
+	rng := newTestRand()
+
+	// K<1 should not panic and should still return n individuals.
+	selected := TournamentSelector{K: 0}.Select(rng, population, 3)
+	if len(selected) != 3 {
+		t.Fatalf("len(selected) = %d, want 3", len(selected))
+	}
+}
+
+func TestRouletteAndRankAndSUSSelectorsReturnRequestedCount(t *testing.T) {
+	SetBenchmark("booth")
+	population := evaluatedPopulation([][2]float64{{1, 3}, {0, 0}, {5, 5}, {-2, 8}, {3, 3}})
+
+	selectors := map[string]Selector{
+		"roulette": RouletteSelector{},
+		"rank":     RankSelector{},
+		"sus":      SUSSelector{},
+	}
+	for name, selector := range selectors {
+		rng := newTestRand()
+		selected := selector.Select(rng, population, 7)
+		if len(selected) != 7 {
+			t.Errorf("%s: len(selected) = %d, want 7", name, len(selected))
+		}
+		for _, s := range selected {
+			if !populationContains(population, s) {
+				t.Errorf("%s: selected individual %+v not found in population", name, s)
+			}
+		}
+	}
+}
+
+func TestFitnessWeightsFavorLowerFitness(t *testing.T) {
+	SetBenchmark("booth")
+	population := evaluatedPopulation([][2]float64{{1, 3}, {50, 50}})
+	weights := fitnessWeights(population)
+
+	if weights[0] <= weights[1] {
+		t.Errorf("expected the near-optimal individual to get a larger weight, got %v", weights)
+	}
+}
+
+func populationContains(population []Arg, target Arg) bool {
+	for _, individual := range population {
+		if individual.x == target.x && individual.y == target.y {
+			return true
+		}
+	}
+	return false
+}